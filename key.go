@@ -0,0 +1,138 @@
+package contextvalue
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// typeOf returns the reflect.Type of T, including for interface types whose
+// zero value is nil.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// Key is a reusable handle for a value of type T carried on a context.Context.
+// Unlike With/From, which key off the type parameter alone, a Key also carries
+// a debug name (used only by String, and otherwise cosmetic) and a default
+// value returned by Value when the context has no binding.
+//
+// Each Key returned by NewKey has its own storage identity, distinct from
+// every other Key and from WithName, even when two Keys share a debug name.
+//
+// The zero value of Key[T] is usable: it behaves like the unnamed key used by
+// With and From, so code built around Key interoperates with plain With/From
+// calls for the same T.
+type Key[T any] struct {
+	id   *byte
+	name string
+	def  T
+}
+
+// NewKey creates a Key[T] with the given debug name and default value. The
+// returned Key has a storage identity of its own, so two Keys created with
+// the same debug name never alias each other.
+func NewKey[T any](name string, def T) Key[T] {
+	return Key[T]{id: new(byte), name: name, def: def}
+}
+
+// identity returns the context key under which k stores its value. A
+// zero-value Key falls back to the identity used by With and From; a Key
+// created by NewKey uses the unique token allocated for it.
+func (k Key[T]) identity() any {
+	if k.id == nil {
+		return key[T]{}
+	}
+	return k.id
+}
+
+// WithValue stores value under k in the provided context and returns the
+// updated context.
+func (k Key[T]) WithValue(ctx context.Context, value T) context.Context {
+	return context.WithValue(ctx, k.identity(), value)
+}
+
+// Value retrieves the value stored under k from the provided context. It
+// returns k's default value if the context has no binding for k.
+func (k Key[T]) Value(ctx context.Context) T {
+	v, ok := k.ValueOk(ctx)
+	if !ok {
+		return k.def
+	}
+	return v
+}
+
+// ValueOk retrieves the value stored under k from the provided context. It
+// returns the value and a boolean indicating whether the value was found.
+func (k Key[T]) ValueOk(ctx context.Context) (T, bool) {
+	v, ok := ctx.Value(k.identity()).(T)
+	return v, ok
+}
+
+// Without hides the value stored under k by setting it to nil. It returns
+// the updated context.
+func (k Key[T]) Without(ctx context.Context) context.Context {
+	return context.WithValue(ctx, k.identity(), nil)
+}
+
+// String returns the debug name of k together with its type parameter T, for
+// use when logging or debugging a context.
+func (k Key[T]) String() string {
+	name := k.name
+	if name == "" {
+		name = "<anonymous>"
+	}
+	return fmt.Sprintf("%s(%s)", name, typeOf[T]())
+}
+
+// NamedKey is the named counterpart to Key: it mirrors WithName/FromName,
+// carrying a name of type N alongside the default value.
+//
+// The zero value of NamedKey[T, N] is usable: WithValue/ValueOk key off the
+// zero value of N, so it interoperates with WithName/FromName calls using
+// that same zero name.
+type NamedKey[T any, N comparable] struct {
+	name N
+	def  T
+}
+
+// NewNamedKey creates a NamedKey[T, N] with the given name and default value.
+func NewNamedKey[T any, N comparable](name N, def T) NamedKey[T, N] {
+	return NamedKey[T, N]{name: name, def: def}
+}
+
+// WithValue stores value under k's name in the provided context and returns
+// the updated context.
+func (k NamedKey[T, N]) WithValue(ctx context.Context, value T) context.Context {
+	return context.WithValue(ctx, keyName[T, N]{name: k.name}, value)
+}
+
+// Value retrieves the value stored under k's name from the provided context.
+// It returns k's default value if the context has no binding for k.
+func (k NamedKey[T, N]) Value(ctx context.Context) T {
+	v, ok := k.ValueOk(ctx)
+	if !ok {
+		return k.def
+	}
+	return v
+}
+
+// ValueOk retrieves the value stored under k's name from the provided
+// context. It returns the value and a boolean indicating whether the value
+// was found.
+func (k NamedKey[T, N]) ValueOk(ctx context.Context) (T, bool) {
+	v, ok := ctx.Value(keyName[T, N]{name: k.name}).(T)
+	return v, ok
+}
+
+// Without hides the value stored under k's name by setting it to nil. It
+// returns the updated context.
+func (k NamedKey[T, N]) Without(ctx context.Context) context.Context {
+	return context.WithValue(ctx, keyName[T, N]{name: k.name}, nil)
+}
+
+// String returns k's name together with its type parameter T, for use when
+// logging or debugging a context.
+func (k NamedKey[T, N]) String() string {
+	return fmt.Sprintf("%v(%s)", k.name, typeOf[T]())
+}
@@ -0,0 +1,84 @@
+package contextvalue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/goaux/contextvalue"
+)
+
+func ExampleKey() {
+	type User struct{ Name string }
+
+	var UserKey = contextvalue.NewKey[User]("mypkg.User", User{Name: "guest"})
+
+	ctx := context.TODO()
+	fmt.Println(UserKey.Value(ctx)) // no binding yet: default value
+
+	ctx = UserKey.WithValue(ctx, User{Name: "alice"})
+	fmt.Println(UserKey.Value(ctx))
+
+	user, ok := UserKey.ValueOk(ctx)
+	fmt.Println(user, ok)
+
+	ctx = UserKey.Without(ctx)
+	fmt.Println(UserKey.Value(ctx))
+
+	fmt.Println(UserKey)
+	// Output:
+	// {guest}
+	// {alice}
+	// {alice} true
+	// {guest}
+	// mypkg.User(contextvalue_test.User)
+}
+
+func ExampleKey_interop() {
+	// A zero-value Key behaves like the unnamed key used by With and From.
+	var IntKey contextvalue.Key[int]
+
+	ctx := contextvalue.With(context.TODO(), 42)
+	fmt.Println(IntKey.Value(ctx))
+
+	ctx = IntKey.WithValue(context.TODO(), 99)
+	fmt.Println(contextvalue.From[int](ctx))
+	// Output:
+	// 42
+	// 99 true
+}
+
+func TestKey_sameNameDoesNotAlias(t *testing.T) {
+	keyA := contextvalue.NewKey[int]("config", 0)
+	keyB := contextvalue.NewKey[int]("config", 0)
+
+	ctx := keyA.WithValue(context.TODO(), 1)
+	if _, ok := keyB.ValueOk(ctx); ok {
+		t.Error("keyB must not see the value stored under keyA, despite sharing a debug name")
+	}
+
+	ctx = contextvalue.WithName(context.TODO(), "config", 2)
+	if _, ok := keyA.ValueOk(ctx); ok {
+		t.Error("keyA must not see a value stored via WithName with the same name string")
+	}
+}
+
+func ExampleNamedKey() {
+	type Name int
+	const Red Name = iota
+
+	RedKey := contextvalue.NewNamedKey[string](Red, "none")
+
+	ctx := context.TODO()
+	fmt.Println(RedKey.Value(ctx))
+
+	ctx = RedKey.WithValue(ctx, "RED")
+	fmt.Println(RedKey.Value(ctx))
+
+	ctx = RedKey.Without(ctx)
+	fmt.Println(RedKey.Value(ctx))
+	// Output:
+	// none
+	// RED
+	// none
+}
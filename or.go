@@ -0,0 +1,46 @@
+package contextvalue
+
+import (
+	"context"
+	"fmt"
+)
+
+// FromOr retrieves a value of type T from the provided context. It returns
+// fallback if no value of type T is present.
+func FromOr[T any](ctx context.Context, fallback T) T {
+	v, ok := From[T](ctx)
+	if !ok {
+		return fallback
+	}
+	return v
+}
+
+// FromNameOr retrieves a named value of type T from the provided context. It
+// returns fallback if no value of type T with the given name is present.
+func FromNameOr[T any, N comparable](ctx context.Context, name N, fallback T) T {
+	v, ok := FromName[T](ctx, name)
+	if !ok {
+		return fallback
+	}
+	return v
+}
+
+// MustFrom retrieves a value of type T from the provided context. It panics
+// if no value of type T is present.
+func MustFrom[T any](ctx context.Context) T {
+	v, ok := From[T](ctx)
+	if !ok {
+		panic(fmt.Sprintf("contextvalue: no value of type %s in context", typeOf[T]()))
+	}
+	return v
+}
+
+// MustFromName retrieves a named value of type T from the provided context.
+// It panics if no value of type T with the given name is present.
+func MustFromName[T any, N comparable](ctx context.Context, name N) T {
+	v, ok := FromName[T](ctx, name)
+	if !ok {
+		panic(fmt.Sprintf("contextvalue: no value of type %s named %v in context", typeOf[T](), name))
+	}
+	return v
+}
@@ -0,0 +1,63 @@
+package contextvalue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/goaux/contextvalue"
+)
+
+func ExampleFromOr() {
+	ctx := context.TODO()
+	fmt.Println(contextvalue.FromOr(ctx, 7))
+
+	ctx = contextvalue.With(ctx, 42)
+	fmt.Println(contextvalue.FromOr(ctx, 7))
+	// Output:
+	// 7
+	// 42
+}
+
+func ExampleFromNameOr() {
+	ctx := context.TODO()
+	fmt.Println(contextvalue.FromNameOr(ctx, "RED", "none"))
+
+	ctx = contextvalue.WithName(ctx, "RED", "crimson")
+	fmt.Println(contextvalue.FromNameOr(ctx, "RED", "none"))
+	// Output:
+	// none
+	// crimson
+}
+
+func ExampleMustFrom() {
+	ctx := contextvalue.With(context.TODO(), 42)
+	fmt.Println(contextvalue.MustFrom[int](ctx))
+	// Output:
+	// 42
+}
+
+func ExampleMustFromName() {
+	ctx := contextvalue.WithName(context.TODO(), "RED", 42)
+	fmt.Println(contextvalue.MustFromName[int](ctx, "RED"))
+	// Output:
+	// 42
+}
+
+func TestMustFrom_panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("must panic when the value is absent")
+		}
+	}()
+	contextvalue.MustFrom[int](context.TODO())
+}
+
+func TestMustFromName_panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("must panic when the named value is absent")
+		}
+	}()
+	contextvalue.MustFromName[int](context.TODO(), "RED")
+}
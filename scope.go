@@ -0,0 +1,71 @@
+package contextvalue
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+type scopeKey struct{}
+
+// WithScope attaches a mutable scope to the provided context and returns the
+// updated context. The scope is backed by a single *sync.Map shared by every
+// context derived from the result, so a pipeline of functions can Store and
+// Load values in place without deriving a new context per mutation, unlike
+// With/WithName.
+func WithScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, scopeKey{}, &sync.Map{})
+}
+
+// scopeOf returns the *sync.Map attached by WithScope. It panics if ctx has
+// no scope.
+func scopeOf(ctx context.Context) *sync.Map {
+	m, ok := ctx.Value(scopeKey{}).(*sync.Map)
+	if !ok {
+		panic("contextvalue: WithScope was not called on this context")
+	}
+	return m
+}
+
+type scopeMapKey struct {
+	typ  reflect.Type
+	name any
+}
+
+// Store saves a value of type T into the scope attached to ctx by WithScope.
+// It panics if ctx has no scope.
+func Store[T any](ctx context.Context, value T) {
+	scopeOf(ctx).Store(scopeMapKey{typ: typeOf[T]()}, value)
+}
+
+// StoreName saves a named value of type T into the scope attached to ctx by
+// WithScope. It panics if ctx has no scope.
+func StoreName[T any, N comparable](ctx context.Context, name N, value T) {
+	scopeOf(ctx).Store(scopeMapKey{typ: typeOf[T](), name: name}, value)
+}
+
+// Load retrieves a value of type T from the scope attached to ctx by
+// WithScope. It returns the value and a boolean indicating whether the
+// value was found. It panics if ctx has no scope.
+func Load[T any](ctx context.Context) (T, bool) {
+	v, ok := scopeOf(ctx).Load(scopeMapKey{typ: typeOf[T]()})
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	value, ok := v.(T)
+	return value, ok
+}
+
+// LoadName retrieves a named value of type T from the scope attached to ctx
+// by WithScope. It returns the value and a boolean indicating whether the
+// value was found. It panics if ctx has no scope.
+func LoadName[T any, N comparable](ctx context.Context, name N) (T, bool) {
+	v, ok := scopeOf(ctx).Load(scopeMapKey{typ: typeOf[T](), name: name})
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	value, ok := v.(T)
+	return value, ok
+}
@@ -0,0 +1,52 @@
+package contextvalue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/goaux/contextvalue"
+)
+
+func ExampleWithScope() {
+	ctx := contextvalue.WithScope(context.TODO())
+
+	contextvalue.Store(ctx, 42)
+	fmt.Println(contextvalue.Load[int](ctx))
+
+	// Store overwrites in place; no new context is needed.
+	contextvalue.Store(ctx, 99)
+	fmt.Println(contextvalue.Load[int](ctx))
+
+	contextvalue.StoreName(ctx, "RED", "crimson")
+	fmt.Println(contextvalue.LoadName[string](ctx, "RED"))
+	// Output:
+	// 42 true
+	// 99 true
+	// crimson true
+}
+
+func ExampleLoad_missing() {
+	ctx := contextvalue.WithScope(context.TODO())
+	fmt.Println(contextvalue.Load[int](ctx))
+	// Output:
+	// 0 false
+}
+
+func TestLoad_panicsWithoutScope(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("must panic when WithScope was not called")
+		}
+	}()
+	contextvalue.Load[int](context.TODO())
+}
+
+func TestStore_panicsWithoutScope(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("must panic when WithScope was not called")
+		}
+	}()
+	contextvalue.Store(context.TODO(), 42)
+}
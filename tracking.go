@@ -0,0 +1,122 @@
+package contextvalue
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type trackingKey struct{}
+
+// trackingNode is one link in the immutable history recorded on a context
+// created by WithTracking. Each With/WithName/Without/WithoutName call on a
+// tracked context prepends a new node; the context itself never changes
+// which node a parent sees.
+type trackingNode struct {
+	prev   *trackingNode
+	typ    reflect.Type
+	name   any
+	value  any
+	hidden bool
+}
+
+// WithTracking returns a child context that records every subsequent
+// With, WithName, Without, and WithoutName call made on it (or on any
+// context derived from it) into an internal history, so the bindings can
+// later be inspected with Snapshot. Contexts that never call WithTracking
+// pay no extra cost beyond a single ctx.Value lookup per With, WithName,
+// Without, or WithoutName call.
+func WithTracking(ctx context.Context) context.Context {
+	return context.WithValue(ctx, trackingKey{}, (*trackingNode)(nil))
+}
+
+// track records an entry in ctx's tracking history, if any, and returns the
+// resulting context. It is a no-op, returning ctx unchanged, for contexts
+// that never called WithTracking.
+func track[T any](ctx context.Context, name, value any, hidden bool) context.Context {
+	head, ok := ctx.Value(trackingKey{}).(*trackingNode)
+	if !ok {
+		return ctx
+	}
+	node := &trackingNode{prev: head, typ: typeOf[T](), name: name, value: value, hidden: hidden}
+	return context.WithValue(ctx, trackingKey{}, node)
+}
+
+// Entry describes one binding recorded on a context created with
+// WithTracking.
+type Entry struct {
+	// Type is the reflect.Type of the type parameter T the binding was made
+	// with; for an interface T it is the interface type, not the dynamic type
+	// of Value.
+	Type reflect.Type
+	// Name is the name the value was bound under, or nil for unnamed
+	// bindings made with With/Without.
+	Name any
+	// Value is the current value, or nil if Hidden is true.
+	Value any
+	// Hidden reports whether the binding was removed by Without/WithoutName.
+	Hidden bool
+}
+
+// Snapshot returns the bindings recorded on ctx since the most recent
+// WithTracking call, most recently set first. An entry shadowed by a later
+// binding or removal of the same type and name is omitted, keeping only the
+// most recent entry for each; that entry has Hidden set to true, and Value
+// nil, if it was last removed by Without/WithoutName rather than set.
+// Snapshot returns nil if ctx was never passed to WithTracking.
+func Snapshot(ctx context.Context) []Entry {
+	head, ok := ctx.Value(trackingKey{}).(*trackingNode)
+	if !ok {
+		return nil
+	}
+	type ident struct {
+		typ  reflect.Type
+		name any
+	}
+	seen := make(map[ident]bool)
+	var entries []Entry
+	for n := head; n != nil; n = n.prev {
+		id := ident{typ: n.typ, name: n.name}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		entries = append(entries, Entry{Type: n.typ, Name: n.name, Value: n.value, Hidden: n.hidden})
+	}
+	return entries
+}
+
+// SnapshotByType returns the entries from Snapshot whose Type is T.
+func SnapshotByType[T any](ctx context.Context) []Entry {
+	typ := typeOf[T]()
+	var entries []Entry
+	for _, e := range Snapshot(ctx) {
+		if e.Type == typ {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// Format renders the bindings recorded on ctx as a human-readable string,
+// one binding per line, suitable for logging.
+func Format(ctx context.Context) string {
+	entries := Snapshot(ctx)
+	var b strings.Builder
+	for i, e := range entries {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		value := any("<hidden>")
+		if !e.Hidden {
+			value = e.Value
+		}
+		if e.Name != nil {
+			fmt.Fprintf(&b, "%s(%v) = %v", e.Type, e.Name, value)
+		} else {
+			fmt.Fprintf(&b, "%s = %v", e.Type, value)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,74 @@
+package contextvalue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/goaux/contextvalue"
+)
+
+func ExampleWithTracking() {
+	ctx := contextvalue.WithTracking(context.TODO())
+
+	ctx = contextvalue.With(ctx, 42)
+	ctx = contextvalue.WithName(ctx, "RED", "crimson")
+	ctx = contextvalue.With(ctx, "scarlet") // same type as the named binding above, but unnamed: a separate entry
+	ctx = contextvalue.WithoutName[string](ctx, "RED")
+
+	fmt.Println(contextvalue.Format(ctx))
+	// Output:
+	// string(RED) = <hidden>
+	// string = scarlet
+	// int = 42
+}
+
+func ExampleSnapshotByType() {
+	ctx := contextvalue.WithTracking(context.TODO())
+	ctx = contextvalue.With(ctx, 42)
+	ctx = contextvalue.WithName(ctx, "RED", 99)
+
+	entries := contextvalue.SnapshotByType[int](ctx)
+	fmt.Println(len(entries))
+	// Output:
+	// 2
+}
+
+func ExampleSnapshot_untracked() {
+	ctx := context.TODO()
+	ctx = contextvalue.With(ctx, 42)
+	fmt.Println(contextvalue.Snapshot(ctx))
+	// Output:
+	// []
+}
+
+func TestSnapshot_shadowing(t *testing.T) {
+	ctx := contextvalue.WithTracking(context.TODO())
+	ctx = contextvalue.With(ctx, 1)
+	ctx = contextvalue.With(ctx, 2)
+
+	entries := contextvalue.Snapshot(ctx)
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	if entries[0].Value != 2 {
+		t.Errorf("got %v, want 2", entries[0].Value)
+	}
+}
+
+func TestSnapshot_hiddenMarked(t *testing.T) {
+	ctx := contextvalue.WithTracking(context.TODO())
+	ctx = contextvalue.With(ctx, 42)
+	ctx = contextvalue.Without[int](ctx)
+
+	entries := contextvalue.Snapshot(ctx)
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	if !entries[0].Hidden {
+		t.Error("entry for a Without'd binding must have Hidden set")
+	}
+	if entries[0].Value != nil {
+		t.Errorf("got Value %v, want nil for a hidden entry", entries[0].Value)
+	}
+}
@@ -9,7 +9,8 @@ type key[T any] struct{}
 // With stores a value of type T in the provided context and returns the updated context.
 // It uses a zero-value struct parameterized with the type T as the context key.
 func With[T any](ctx context.Context, value T) context.Context {
-	return context.WithValue(ctx, key[T]{}, value)
+	ctx = context.WithValue(ctx, key[T]{}, value)
+	return track[T](ctx, nil, value, false)
 }
 
 // From retrieves a value of type T from the provided context.
@@ -27,7 +28,8 @@ type keyName[T any, N comparable] struct {
 // It uses a struct containing the name of type N as the context key.
 // The name type N must be comparable.
 func WithName[T any, N comparable](ctx context.Context, name N, value T) context.Context {
-	return context.WithValue(ctx, keyName[T, N]{name: name}, value)
+	ctx = context.WithValue(ctx, keyName[T, N]{name: name}, value)
+	return track[T](ctx, name, value, false)
 }
 
 // FromName retrieves a named value of type T from the provided context.
@@ -40,11 +42,13 @@ func FromName[T any, N comparable](ctx context.Context, name N) (T, bool) {
 // Without hides a value of type T from the provided context by setting it to nil.
 // It returns the updated context.
 func Without[T any](ctx context.Context) context.Context {
-	return context.WithValue(ctx, key[T]{}, nil)
+	ctx = context.WithValue(ctx, key[T]{}, nil)
+	return track[T](ctx, nil, nil, true)
 }
 
 // WithoutName hides a named value of type T from the provided context by setting it to nil.
 // It returns the updated context.
 func WithoutName[T any, N comparable](ctx context.Context, name N) context.Context {
-	return context.WithValue(ctx, keyName[T, N]{name: name}, nil)
+	ctx = context.WithValue(ctx, keyName[T, N]{name: name}, nil)
+	return track[T](ctx, name, nil, true)
 }